@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/erickmanovei/webhookinspector-cli/pkg/config"
+	"github.com/spf13/cobra"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Show the current configuration",
+	RunE:  runConfig,
+}
+
+func runConfig(_ *cobra.Command, _ []string) error {
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("WebhookInspectorId:", cfg.InspectorID)
+	fmt.Println("Local Endpoint:", cfg.LocalEndpoint)
+	return nil
+}
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/erickmanovei/webhookinspector-cli/pkg/config"
+	"github.com/erickmanovei/webhookinspector-cli/pkg/proxy"
+	"github.com/erickmanovei/webhookinspector-cli/pkg/sink"
+	"github.com/erickmanovei/webhookinspector-cli/pkg/store"
+	"github.com/erickmanovei/webhookinspector-cli/pkg/tui"
+	"github.com/erickmanovei/webhookinspector-cli/pkg/websocket"
+	"github.com/spf13/cobra"
+)
+
+// webhookInspectorWS is the Webhook Inspector WebSocket endpoint.
+const webhookInspectorWS = "ws://ws.webhookinspector.com/ws"
+
+var tuiMode bool
+
+var listenCmd = &cobra.Command{
+	Use:   "listen",
+	Short: "Listen for webhooks and forward them to the local endpoint",
+	RunE:  runListen,
+}
+
+func init() {
+	listenCmd.Flags().BoolVar(&tuiMode, "tui", false, "show a live inspector view instead of plain logs")
+}
+
+// forwardingVisitor implements websocket.Visitor by persisting every
+// webhook payload to the replay store and fanning it out to the
+// configured sinks, and logging the other message kinds. If events is
+// non-nil, every message is also published there for a subscriber such
+// as the TUI.
+type forwardingVisitor struct {
+	store  *store.Store
+	sinks  sink.FanOut
+	proxy  *proxy.Proxy
+	events chan<- tui.Event
+}
+
+func (v *forwardingVisitor) VisitWebhook(payload websocket.WebhookPayload) {
+	v.publish(tui.Event{Kind: tui.EventWebhook, At: time.Now(), Webhook: payload})
+
+	if _, err := v.store.Save(payload); err != nil {
+		fmt.Println("Error saving webhook to history:", err)
+	}
+
+	target := v.proxy.Resolve(payload)
+	status := "ok"
+	outcome, err := v.sinks.Send(payload)
+	if err != nil {
+		fmt.Println("Error delivering webhook:", err)
+		status = err.Error()
+	} else {
+		logSignatureCheck(outcome)
+		fmt.Println("Webhook delivered. Target:", target)
+	}
+
+	event := tui.Event{Kind: tui.EventForwarded, At: time.Now(), Webhook: payload, Target: target, Status: status}
+	if outcome != nil {
+		event.ResponseStatusCode = outcome.StatusCode
+		event.ResponseBody = outcome.Body
+	}
+	v.publish(event)
+}
+
+func (v *forwardingVisitor) VisitError(text string) {
+	fmt.Println("Error from server:", text)
+	v.publish(tui.Event{Kind: tui.EventError, At: time.Now(), Text: text})
+}
+
+func (v *forwardingVisitor) VisitWarning(text string) {
+	fmt.Println("Warning from server:", text)
+	v.publish(tui.Event{Kind: tui.EventWarning, At: time.Now(), Text: text})
+}
+
+func (v *forwardingVisitor) VisitNotice(text string) {
+	fmt.Println("Notice from server:", text)
+	v.publish(tui.Event{Kind: tui.EventNotice, At: time.Now(), Text: text})
+}
+
+// publish sends event to v.events without blocking the websocket read
+// loop; if the subscriber is slow or absent, the event is dropped.
+func (v *forwardingVisitor) publish(event tui.Event) {
+	if v.events == nil {
+		return
+	}
+	select {
+	case v.events <- event:
+	default:
+	}
+}
+
+func runListen(_ *cobra.Command, _ []string) error {
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := withSIGTERMCancel(context.Background())
+	defer cancel()
+
+	client := websocket.NewClient(webhookInspectorWS, cfg.InspectorID)
+	p := proxy.NewWithRules(cfg.LocalEndpoint, cfg.Rules)
+	p.VerifySignature = verifySignature
+	p.HeaderRewrites = cfg.HeaderRewrites
+
+	sinks, err := sink.Build(cfg, p)
+	if err != nil {
+		return err
+	}
+
+	if !tuiMode {
+		fmt.Println("=== Webhook Inspector Client ===")
+		fmt.Println("Using configured WebhookInspectorId and endpoint:")
+		fmt.Println("  WebhookInspectorId:", cfg.InspectorID)
+		fmt.Println("  Local Endpoint:", cfg.LocalEndpoint)
+
+		visitor := &forwardingVisitor{
+			store: store.New(cfg.HistoryDirOrDefault(), cfg.HistorySizeOrDefault()),
+			sinks: sinks,
+			proxy: p,
+		}
+		client.Run(ctx, visitor)
+		fmt.Println("\nReceived interrupt signal. Closing...")
+		return nil
+	}
+
+	events := make(chan tui.Event, 256)
+	visitor := &forwardingVisitor{
+		store:  store.New(cfg.HistoryDirOrDefault(), cfg.HistorySizeOrDefault()),
+		sinks:  sinks,
+		proxy:  p,
+		events: events,
+	}
+
+	go client.Run(ctx, visitor)
+
+	program := tea.NewProgram(tui.New(p, sinks, events), tea.WithAltScreen())
+	go func() {
+		<-ctx.Done()
+		program.Quit()
+	}()
+	if _, err := program.Run(); err != nil {
+		return fmt.Errorf("running tui: %w", err)
+	}
+	cancel()
+	return nil
+}
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/erickmanovei/webhookinspector-cli/pkg/config"
+	"github.com/spf13/cobra"
+)
+
+var loginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Prompt for and save the inspector ID and local endpoint",
+	RunE:  runLogin,
+}
+
+func runLogin(_ *cobra.Command, _ []string) error {
+	if _, err := os.Stat(configPath); err == nil {
+		if err := os.Remove(configPath); err != nil {
+			return fmt.Errorf("removing existing config file: %w", err)
+		}
+	}
+
+	_, err := config.Load(configPath)
+	return err
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/erickmanovei/webhookinspector-cli/pkg/config"
+	"github.com/erickmanovei/webhookinspector-cli/pkg/proxy"
+	"github.com/erickmanovei/webhookinspector-cli/pkg/sink"
+	"github.com/erickmanovei/webhookinspector-cli/pkg/store"
+	"github.com/spf13/cobra"
+)
+
+var replaySince string
+
+var replayCmd = &cobra.Command{
+	Use:   "replay [id|index]",
+	Short: "Re-send a previously received webhook to the local endpoint",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  runReplay,
+}
+
+func init() {
+	replayCmd.Flags().StringVar(&replaySince, "since", "", "replay every event received at or after this RFC3339 timestamp")
+}
+
+func runReplay(_ *cobra.Command, args []string) error {
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 0 && replaySince == "" {
+		return fmt.Errorf("replay: specify an id/index argument or --since")
+	}
+	if len(args) == 1 && replaySince != "" {
+		return fmt.Errorf("replay: cannot combine an id/index argument with --since")
+	}
+
+	s := store.New(cfg.HistoryDirOrDefault(), cfg.HistorySizeOrDefault())
+	p := proxy.NewWithRules(cfg.LocalEndpoint, cfg.Rules)
+	p.VerifySignature = verifySignature
+	p.HeaderRewrites = cfg.HeaderRewrites
+
+	sinks, err := sink.Build(cfg, p)
+	if err != nil {
+		return err
+	}
+
+	var records []store.Record
+	if replaySince != "" {
+		since, err := time.Parse(time.RFC3339, replaySince)
+		if err != nil {
+			return fmt.Errorf("parsing --since: %w", err)
+		}
+		records, err = s.Since(since)
+		if err != nil {
+			return err
+		}
+	} else {
+		record, err := s.Find(args[0])
+		if err != nil {
+			return err
+		}
+		records = []store.Record{record}
+	}
+
+	for _, record := range records {
+		fmt.Println("Replaying webhook", record.EventID, "received at", record.ReceivedAt)
+		outcome, err := sinks.Send(record.Payload)
+		if err != nil {
+			fmt.Println("Error replaying webhook:", err)
+			continue
+		}
+		logSignatureCheck(outcome)
+		fmt.Println("Webhook successfully delivered to all configured sinks.")
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+// Package cmd wires up the webhookinspector CLI's subcommands.
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var configPath string
+var verifySignature bool
+
+var rootCmd = &cobra.Command{
+	Use:   "webhookinspector",
+	Short: "Forward Webhook Inspector events to a local endpoint",
+}
+
+// Execute runs the root command, parsing os.Args.
+func Execute() error {
+	return rootCmd.Execute()
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&configPath, "config", "config.json", "path to the configuration file")
+	rootCmd.PersistentFlags().BoolVar(&verifySignature, "verify-signature", false, "check for a known signature header before forwarding a webhook")
+
+	rootCmd.AddCommand(listenCmd)
+	rootCmd.AddCommand(loginCmd)
+	rootCmd.AddCommand(configCmd)
+	rootCmd.AddCommand(replayCmd)
+}
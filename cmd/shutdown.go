@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// withSIGTERMCancel returns a context that is cancelled as soon as the
+// process receives SIGINT or SIGTERM, and a cancel func to release the
+// underlying signal handler early.
+func withSIGTERMCancel(parent context.Context) (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(parent, os.Interrupt, syscall.SIGTERM)
+}
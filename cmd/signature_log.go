@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/erickmanovei/webhookinspector-cli/pkg/sink"
+)
+
+// logSignatureCheck prints the --verify-signature result for a
+// delivery, if any was performed (outcome is nil for sinks with no
+// response, and SignatureChecked is only set by an HTTPSink whose
+// Proxy has VerifySignature set).
+func logSignatureCheck(outcome *sink.Outcome) {
+	if outcome == nil || !outcome.SignatureChecked {
+		return
+	}
+	if outcome.SignatureDetected {
+		fmt.Println("Detected signature header:", outcome.SignatureHeader)
+	} else {
+		fmt.Println("Warning: no known signature header present; forwarded payload cannot be verified downstream")
+	}
+}
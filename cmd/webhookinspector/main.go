@@ -0,0 +1,17 @@
+// Command webhookinspector forwards events from the Webhook Inspector
+// service to a local endpoint.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/erickmanovei/webhookinspector-cli/cmd"
+)
+
+func main() {
+	if err := cmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
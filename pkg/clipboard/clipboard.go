@@ -0,0 +1,39 @@
+// Package clipboard copies text to the system clipboard using
+// whatever platform utility is available, best-effort.
+package clipboard
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"runtime"
+)
+
+// Copy writes text to the system clipboard. It shells out to pbcopy on
+// macOS, clip.exe on Windows, and xclip or xsel on Linux/BSD; it
+// returns an error if none of those are available.
+func Copy(text string) error {
+	cmd, err := clipboardCommand()
+	if err != nil {
+		return err
+	}
+	cmd.Stdin = bytes.NewBufferString(text)
+	return cmd.Run()
+}
+
+func clipboardCommand() (*exec.Cmd, error) {
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("pbcopy"), nil
+	case "windows":
+		return exec.Command("clip"), nil
+	default:
+		if path, err := exec.LookPath("xclip"); err == nil {
+			return exec.Command(path, "-selection", "clipboard"), nil
+		}
+		if path, err := exec.LookPath("xsel"); err == nil {
+			return exec.Command(path, "--clipboard", "--input"), nil
+		}
+		return nil, fmt.Errorf("no clipboard utility found (tried xclip, xsel)")
+	}
+}
@@ -0,0 +1,177 @@
+// Package config loads and persists the CLI's local configuration file.
+package config
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// DefaultPath is the file name used to persist configuration in the
+// current working directory.
+const DefaultPath = "config.json"
+
+// Config represents the persistent CLI settings.
+type Config struct {
+	InspectorID string `json:"inspectorId"`
+	// LocalEndpoint is the fallback forward target used when no Rule
+	// matches a received webhook.
+	LocalEndpoint string `json:"localEndpoint"`
+	// Rules routes webhooks to different local endpoints based on
+	// their path and/or headers, evaluated in order. The first
+	// matching rule wins; if none match, LocalEndpoint is used.
+	Rules []Rule `json:"rules,omitempty"`
+	// HistoryDir is the directory received webhooks are persisted to
+	// for replay. Defaults to DefaultHistoryDir.
+	HistoryDir string `json:"historyDir,omitempty"`
+	// HistorySize is how many received webhooks are retained on disk.
+	// Defaults to DefaultHistorySize.
+	HistorySize int `json:"historySize,omitempty"`
+	// Sinks fans out every received webhook to each declared
+	// destination. When set, LocalEndpoint is not forwarded to; an
+	// "http" sink still routes through Rules, falling back to its own
+	// Endpoint. If empty, webhooks are forwarded over HTTP to
+	// LocalEndpoint/Rules only, preserving the pre-sinks behavior.
+	Sinks []SinkConfig `json:"sinks,omitempty"`
+	// HeaderRewrites is applied, in order, to every header set on an
+	// HTTP forward after the original request headers are copied.
+	HeaderRewrites []HeaderRewrite `json:"headerRewrites,omitempty"`
+}
+
+// HeaderRewrite overrides or removes a header on the forwarded HTTP
+// request. An empty Value removes Name instead of setting it, which is
+// useful for stripping a header the original sender set but the local
+// endpoint should not see.
+type HeaderRewrite struct {
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+}
+
+// SinkConfig declares one outbound destination a webhook is fanned out
+// to. Which fields apply depends on Type.
+type SinkConfig struct {
+	// Type selects the sink implementation: "http", "exec", "file", or
+	// "nats".
+	Type string `json:"type"`
+
+	// Endpoint is the target URL for Type "http" when no Rule matches.
+	// Rules are consulted first; LocalEndpoint is not used.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Command and Args run a local script for Type "exec". The
+	// webhook body is piped to its stdin; headers, method, path and id
+	// are passed as WEBHOOK_* environment variables.
+	Command string   `json:"command,omitempty"`
+	Args    []string `json:"args,omitempty"`
+	// TimeoutSeconds bounds how long the Type "exec" command may run
+	// before it is killed. Defaults to sink.DefaultExecTimeout.
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+
+	// Path is the JSONL file appended to for Type "file".
+	Path string `json:"path,omitempty"`
+
+	// URL and Subject address a NATS server for Type "nats".
+	URL     string `json:"url,omitempty"`
+	Subject string `json:"subject,omitempty"`
+}
+
+// DefaultHistoryDir is used when Config.HistoryDir is not set.
+const DefaultHistoryDir = ".webhook-history"
+
+// DefaultHistorySize is used when Config.HistorySize is not set.
+const DefaultHistorySize = 100
+
+// HistoryDirOrDefault returns c.HistoryDir, or DefaultHistoryDir if unset.
+func (c *Config) HistoryDirOrDefault() string {
+	if c.HistoryDir == "" {
+		return DefaultHistoryDir
+	}
+	return c.HistoryDir
+}
+
+// HistorySizeOrDefault returns c.HistorySize, or DefaultHistorySize if unset.
+func (c *Config) HistorySizeOrDefault() int {
+	if c.HistorySize == 0 {
+		return DefaultHistorySize
+	}
+	return c.HistorySize
+}
+
+// Rule routes a webhook matching Match to Forward.
+type Rule struct {
+	Match   Match  `json:"match"`
+	Forward string `json:"forward"`
+}
+
+// Match describes the conditions a webhook must meet for its Rule to
+// apply. Both fields are optional; an empty field always matches.
+type Match struct {
+	// Path is a glob pattern (e.g. "/stripe/*") matched against the
+	// webhook's original request path.
+	Path string `json:"path,omitempty"`
+	// Header is a "Name=value" pair where value is a glob pattern
+	// (e.g. "X-Event-Type=payment.*") matched against the named
+	// request header.
+	Header string `json:"header,omitempty"`
+}
+
+// Load reads the configuration from path. If the file does not exist,
+// it prompts the user on stdin and saves the result to path.
+func Load(path string) (*Config, error) {
+	if _, err := os.Stat(path); err == nil {
+		return read(path)
+	} else if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("checking config file: %w", err)
+	}
+
+	cfg, err := prompt()
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.Save(path); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func read(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading config file: %w", err)
+	}
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing config file: %w", err)
+	}
+	return &cfg, nil
+}
+
+func prompt() (*Config, error) {
+	reader := bufio.NewReader(os.Stdin)
+
+	fmt.Print("Enter WebhookInspectorId: ")
+	id, _ := reader.ReadString('\n')
+
+	fmt.Print("Enter the local endpoint URL to forward webhooks: ")
+	endpoint, _ := reader.ReadString('\n')
+
+	return &Config{
+		InspectorID:   strings.TrimSpace(id),
+		LocalEndpoint: strings.TrimSpace(endpoint),
+	}, nil
+}
+
+// Save writes the configuration to path as indented JSON.
+func (c *Config) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("creating config file: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("saving config file: %w", err)
+	}
+	fmt.Println("Configuration saved in", path)
+	return nil
+}
@@ -0,0 +1,50 @@
+// Package match evaluates the glob-style path and header match rules
+// used to route webhooks to different forward targets.
+package match
+
+import (
+	"path"
+	"strings"
+)
+
+// Request is the subset of a received webhook that rules are matched
+// against.
+type Request struct {
+	Path    string
+	Headers map[string]string
+}
+
+// Matches reports whether req satisfies both the path and header
+// conditions, if present. An empty condition always matches.
+func Matches(matchPath, matchHeader string, req Request) bool {
+	if matchPath != "" && !matchesPath(matchPath, req.Path) {
+		return false
+	}
+	if matchHeader != "" && !matchesHeader(matchHeader, req.Headers) {
+		return false
+	}
+	return true
+}
+
+func matchesPath(pattern, p string) bool {
+	ok, err := path.Match(pattern, p)
+	return err == nil && ok
+}
+
+// matchesHeader parses rule as "Name=pattern" and reports whether
+// req.Headers[Name] glob-matches pattern. Header name lookup is
+// case-insensitive.
+func matchesHeader(rule string, headers map[string]string) bool {
+	name, pattern, found := strings.Cut(rule, "=")
+	if !found {
+		return false
+	}
+
+	for key, value := range headers {
+		if strings.EqualFold(key, name) {
+			ok, err := path.Match(pattern, value)
+			return err == nil && ok
+		}
+	}
+	return false
+}
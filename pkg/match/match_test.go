@@ -0,0 +1,64 @@
+package match
+
+import "testing"
+
+func TestMatchesPathOnly(t *testing.T) {
+	req := Request{Path: "/stripe/charge"}
+
+	if !Matches("/stripe/*", "", req) {
+		t.Error("expected glob pattern to match path")
+	}
+	if Matches("/shopify/*", "", req) {
+		t.Error("expected glob pattern not to match a different path")
+	}
+}
+
+func TestMatchesHeaderOnly(t *testing.T) {
+	req := Request{Headers: map[string]string{"X-Event-Type": "payment.succeeded"}}
+
+	if !Matches("", "X-Event-Type=payment.*", req) {
+		t.Error("expected header glob to match")
+	}
+	if Matches("", "X-Event-Type=refund.*", req) {
+		t.Error("expected header glob not to match a different value")
+	}
+}
+
+func TestMatchesHeaderIsCaseInsensitive(t *testing.T) {
+	req := Request{Headers: map[string]string{"x-event-type": "payment.succeeded"}}
+
+	if !Matches("", "X-Event-Type=payment.*", req) {
+		t.Error("expected header name lookup to be case-insensitive")
+	}
+}
+
+func TestMatchesRequiresBothConditions(t *testing.T) {
+	req := Request{
+		Path:    "/stripe/charge",
+		Headers: map[string]string{"X-Event-Type": "payment.succeeded"},
+	}
+
+	if !Matches("/stripe/*", "X-Event-Type=payment.*", req) {
+		t.Error("expected both conditions to match")
+	}
+	if Matches("/stripe/*", "X-Event-Type=refund.*", req) {
+		t.Error("expected match to fail when the header condition fails")
+	}
+	if Matches("/shopify/*", "X-Event-Type=payment.*", req) {
+		t.Error("expected match to fail when the path condition fails")
+	}
+}
+
+func TestMatchesEmptyConditionsAlwaysMatch(t *testing.T) {
+	if !Matches("", "", Request{}) {
+		t.Error("expected no conditions to always match")
+	}
+}
+
+func TestMatchesHeaderMissingHeaderRule(t *testing.T) {
+	req := Request{Headers: map[string]string{"X-Event-Type": "payment.succeeded"}}
+
+	if Matches("", "no-equals-sign", req) {
+		t.Error("expected a header rule with no '=' to never match")
+	}
+}
@@ -0,0 +1,175 @@
+// Package proxy forwards webhook payloads received from the inspector
+// service to a local HTTP endpoint.
+package proxy
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/erickmanovei/webhookinspector-cli/pkg/config"
+	"github.com/erickmanovei/webhookinspector-cli/pkg/match"
+	"github.com/erickmanovei/webhookinspector-cli/pkg/signature"
+	"github.com/erickmanovei/webhookinspector-cli/pkg/websocket"
+)
+
+// DefaultTimeout bounds how long a forwarded request may take before
+// the local endpoint is considered unresponsive.
+const DefaultTimeout = 30 * time.Second
+
+// hopByHopHeaders are stripped from the original request before
+// forwarding, per RFC 7230 section 6.1; they describe the connection
+// to the inspector service, not the webhook itself.
+var hopByHopHeaders = map[string]bool{
+	"Connection":          true,
+	"Keep-Alive":          true,
+	"Proxy-Authenticate":  true,
+	"Proxy-Authorization": true,
+	"Te":                  true,
+	"Trailer":             true,
+	"Transfer-Encoding":   true,
+	"Upgrade":             true,
+	"Host":                true,
+}
+
+// Proxy forwards webhook payloads to a local endpoint, optionally
+// routed per config.Rule.
+type Proxy struct {
+	// Endpoint is the fallback local URL used when no Rule matches.
+	Endpoint string
+	// Rules routes payloads to different endpoints, evaluated in
+	// order; the first match wins.
+	Rules []config.Rule
+	// VerifySignature, when set, makes Forward record in its Result
+	// whether a known signature header is present on the payload.
+	VerifySignature bool
+	// HeaderRewrites is applied, in order, after the original request
+	// headers are copied onto the forwarded request.
+	HeaderRewrites []config.HeaderRewrite
+
+	client *http.Client
+}
+
+// New creates a Proxy that forwards to endpoint using an HTTP client
+// with DefaultTimeout.
+func New(endpoint string) *Proxy {
+	return &Proxy{
+		Endpoint: endpoint,
+		client:   &http.Client{Timeout: DefaultTimeout},
+	}
+}
+
+// NewWithRules creates a Proxy that routes payloads through rules,
+// falling back to endpoint when no rule matches.
+func NewWithRules(endpoint string, rules []config.Rule) *Proxy {
+	p := New(endpoint)
+	p.Rules = rules
+	return p
+}
+
+// Resolve returns the forward target for payload: the first Rule whose
+// Match is satisfied, or p.Endpoint otherwise.
+func (p *Proxy) Resolve(payload websocket.WebhookPayload) string {
+	req := match.Request{Path: payload.Path, Headers: payload.Headers}
+	for _, rule := range p.Rules {
+		if match.Matches(rule.Match.Path, rule.Match.Header, req) {
+			return rule.Forward
+		}
+	}
+	return p.Endpoint
+}
+
+// Result describes the outcome of a successful Forward call. Callers
+// own presentation: Forward itself never writes to stdout, so it can
+// be used from contexts (like the --tui inspector) that own the
+// terminal themselves.
+type Result struct {
+	// Target is the endpoint the payload was sent to.
+	Target string
+	// StatusCode and Status are the local endpoint's HTTP response.
+	StatusCode int
+	Status     string
+	// Body is the local endpoint's response body.
+	Body []byte
+	// SignatureChecked is true when Proxy.VerifySignature was set for
+	// this Forward call. SignatureHeader/SignatureDetected are only
+	// meaningful when this is true.
+	SignatureChecked  bool
+	SignatureHeader   string
+	SignatureDetected bool
+}
+
+// Forward sends payload to the endpoint resolved from p.Rules (or
+// p.Endpoint as a fallback), adding any query params present on the
+// payload to the target URL.
+func (p *Proxy) Forward(payload websocket.WebhookPayload) (*Result, error) {
+	target := p.Resolve(payload)
+
+	result := &Result{Target: target}
+	if p.VerifySignature {
+		result.SignatureChecked = true
+		name, _, ok := signature.Detect(payload.Headers)
+		result.SignatureHeader = name
+		result.SignatureDetected = ok
+	}
+
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, fmt.Errorf("parsing endpoint URL: %w", err)
+	}
+
+	if len(payload.Query) > 0 {
+		q := u.Query()
+		for key, value := range payload.Query {
+			q.Set(key, value)
+		}
+		u.RawQuery = q.Encode()
+	}
+
+	req, err := http.NewRequest(payload.Method, u.String(), bytes.NewReader(payload.Body))
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+
+	hasContentType := false
+	for key, value := range payload.Headers {
+		if hopByHopHeaders[http.CanonicalHeaderKey(key)] {
+			continue
+		}
+		if strings.EqualFold(key, "Content-Type") {
+			hasContentType = true
+		}
+		req.Header.Set(key, value)
+	}
+	if !hasContentType {
+		req.Header.Set("Content-Type", "application/octet-stream")
+	}
+
+	for _, rewrite := range p.HeaderRewrites {
+		if rewrite.Value == "" {
+			req.Header.Del(rewrite.Name)
+			continue
+		}
+		req.Header.Set(rewrite.Name, rewrite.Value)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("forwarding webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading local endpoint response: %w", err)
+	}
+
+	result.StatusCode = resp.StatusCode
+	result.Status = resp.Status
+	result.Body = body
+	return result, nil
+}
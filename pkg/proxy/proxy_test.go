@@ -0,0 +1,196 @@
+package proxy
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erickmanovei/webhookinspector-cli/pkg/config"
+	"github.com/erickmanovei/webhookinspector-cli/pkg/websocket"
+)
+
+func TestResolveFallsBackToEndpoint(t *testing.T) {
+	p := New("http://fallback.example")
+
+	got := p.Resolve(websocket.WebhookPayload{Path: "/anything"})
+	if got != "http://fallback.example" {
+		t.Errorf("got %q, want fallback endpoint", got)
+	}
+}
+
+func TestResolveMatchesFirstRule(t *testing.T) {
+	p := NewWithRules("http://fallback.example", []config.Rule{
+		{Match: config.Match{Path: "/stripe/*"}, Forward: "http://stripe.example"},
+		{Match: config.Match{Path: "/*"}, Forward: "http://catch-all.example"},
+	})
+
+	got := p.Resolve(websocket.WebhookPayload{Path: "/stripe/charge"})
+	if got != "http://stripe.example" {
+		t.Errorf("got %q, want the first matching rule to win", got)
+	}
+}
+
+func TestForwardStripsHopByHopHeaders(t *testing.T) {
+	var gotHeaders http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeaders = r.Header.Clone()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	p := New(srv.URL)
+	_, err := p.Forward(websocket.WebhookPayload{
+		Method: "POST",
+		Headers: map[string]string{
+			"X-Event":    "payment.created",
+			"Connection": "keep-alive",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Forward: %v", err)
+	}
+
+	if gotHeaders.Get("X-Event") != "payment.created" {
+		t.Errorf("expected X-Event to be forwarded, got %q", gotHeaders.Get("X-Event"))
+	}
+	if gotHeaders.Get("Connection") != "" {
+		t.Errorf("expected hop-by-hop Connection header to be stripped, got %q", gotHeaders.Get("Connection"))
+	}
+}
+
+func TestForwardDefaultsContentType(t *testing.T) {
+	var gotContentType string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContentType = r.Header.Get("Content-Type")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	p := New(srv.URL)
+	if _, err := p.Forward(websocket.WebhookPayload{Method: "POST"}); err != nil {
+		t.Fatalf("Forward: %v", err)
+	}
+
+	if gotContentType != "application/octet-stream" {
+		t.Errorf("got Content-Type %q, want application/octet-stream fallback", gotContentType)
+	}
+}
+
+func TestForwardAppliesHeaderRewrites(t *testing.T) {
+	var gotHeaders http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeaders = r.Header.Clone()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	p := New(srv.URL)
+	p.HeaderRewrites = []config.HeaderRewrite{
+		{Name: "Authorization", Value: "Bearer local-secret"},
+		{Name: "X-Drop-Me", Value: ""},
+	}
+
+	if _, err := p.Forward(websocket.WebhookPayload{
+		Method:  "POST",
+		Headers: map[string]string{"X-Drop-Me": "should be removed"},
+	}); err != nil {
+		t.Fatalf("Forward: %v", err)
+	}
+
+	if gotHeaders.Get("Authorization") != "Bearer local-secret" {
+		t.Errorf("got Authorization %q, want rewrite applied", gotHeaders.Get("Authorization"))
+	}
+	if gotHeaders.Get("X-Drop-Me") != "" {
+		t.Errorf("expected X-Drop-Me to be removed by an empty-value rewrite, got %q", gotHeaders.Get("X-Drop-Me"))
+	}
+}
+
+func TestForwardDetectsSignatureWhenVerifyEnabled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	p := New(srv.URL)
+	p.VerifySignature = true
+
+	result, err := p.Forward(websocket.WebhookPayload{
+		Method:  "POST",
+		Headers: map[string]string{"X-Hub-Signature-256": "sha256=abc123"},
+	})
+	if err != nil {
+		t.Fatalf("Forward: %v", err)
+	}
+
+	if !result.SignatureChecked {
+		t.Fatal("expected SignatureChecked to be true when VerifySignature is set")
+	}
+	if !result.SignatureDetected || result.SignatureHeader != "X-Hub-Signature-256" {
+		t.Errorf("got header=%q detected=%v, want X-Hub-Signature-256/true", result.SignatureHeader, result.SignatureDetected)
+	}
+}
+
+func TestForwardSkipsSignatureCheckWhenVerifyDisabled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	p := New(srv.URL)
+
+	result, err := p.Forward(websocket.WebhookPayload{
+		Method:  "POST",
+		Headers: map[string]string{"X-Hub-Signature-256": "sha256=abc123"},
+	})
+	if err != nil {
+		t.Fatalf("Forward: %v", err)
+	}
+
+	if result.SignatureChecked {
+		t.Error("expected SignatureChecked to stay false when VerifySignature is unset")
+	}
+}
+
+func TestForwardReturnsResponseStatusAndBody(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer srv.Close()
+
+	p := New(srv.URL)
+	result, err := p.Forward(websocket.WebhookPayload{Method: "POST"})
+	if err != nil {
+		t.Fatalf("Forward: %v", err)
+	}
+
+	if result.StatusCode != http.StatusCreated {
+		t.Errorf("got StatusCode %d, want %d", result.StatusCode, http.StatusCreated)
+	}
+	if string(result.Body) != `{"ok":true}` {
+		t.Errorf("got Body %q, want the local endpoint's response body", result.Body)
+	}
+}
+
+func TestForwardAddsQueryParams(t *testing.T) {
+	var gotQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.Copy(io.Discard, r.Body)
+	}))
+	defer srv.Close()
+
+	p := New(srv.URL)
+	if _, err := p.Forward(websocket.WebhookPayload{
+		Method: "POST",
+		Query:  map[string]string{"source": "inspector"},
+	}); err != nil {
+		t.Fatalf("Forward: %v", err)
+	}
+
+	if gotQuery != "source=inspector" {
+		t.Errorf("got query %q, want source=inspector", gotQuery)
+	}
+}
@@ -0,0 +1,29 @@
+// Package signature recognizes the HTTP headers common webhook
+// providers use to carry a payload signature, so callers can confirm a
+// signed webhook kept its signature intact across the round trip.
+package signature
+
+import "strings"
+
+// headerNames lists the signature headers used by common webhook
+// providers, most specific first.
+var headerNames = []string{
+	"X-Hub-Signature-256", // GitHub, Shopify
+	"X-Hub-Signature",     // GitHub (legacy)
+	"Stripe-Signature",    // Stripe
+	"X-Signature",         // generic
+}
+
+// Detect returns the name and value of the first known signature
+// header present in headers, or ok=false if none is present. Header
+// name lookup is case-insensitive.
+func Detect(headers map[string]string) (name, value string, ok bool) {
+	for _, candidate := range headerNames {
+		for key, v := range headers {
+			if strings.EqualFold(key, candidate) {
+				return key, v, true
+			}
+		}
+	}
+	return "", "", false
+}
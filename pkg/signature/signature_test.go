@@ -0,0 +1,53 @@
+package signature
+
+import "testing"
+
+func TestDetectFindsKnownHeader(t *testing.T) {
+	headers := map[string]string{
+		"Content-Type":        "application/json",
+		"X-Hub-Signature-256": "sha256=abc123",
+	}
+
+	name, value, ok := Detect(headers)
+	if !ok {
+		t.Fatal("expected a known signature header to be detected")
+	}
+	if name != "X-Hub-Signature-256" || value != "sha256=abc123" {
+		t.Errorf("got name=%q value=%q, want X-Hub-Signature-256/sha256=abc123", name, value)
+	}
+}
+
+func TestDetectIsCaseInsensitive(t *testing.T) {
+	headers := map[string]string{"stripe-signature": "t=1,v1=abc"}
+
+	name, _, ok := Detect(headers)
+	if !ok {
+		t.Fatal("expected lowercase header name to be detected")
+	}
+	if name != "stripe-signature" {
+		t.Errorf("expected Detect to return the original header casing, got %q", name)
+	}
+}
+
+func TestDetectReturnsFalseWhenAbsent(t *testing.T) {
+	headers := map[string]string{"Content-Type": "application/json"}
+
+	if _, _, ok := Detect(headers); ok {
+		t.Error("expected no known signature header to be detected")
+	}
+}
+
+func TestDetectPrefersMostSpecificHeader(t *testing.T) {
+	headers := map[string]string{
+		"X-Hub-Signature":     "sha1=old",
+		"X-Hub-Signature-256": "sha256=new",
+	}
+
+	name, value, ok := Detect(headers)
+	if !ok {
+		t.Fatal("expected a known signature header to be detected")
+	}
+	if name != "X-Hub-Signature-256" || value != "sha256=new" {
+		t.Errorf("expected the more specific X-Hub-Signature-256 to win, got name=%q value=%q", name, value)
+	}
+}
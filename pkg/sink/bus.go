@@ -0,0 +1,56 @@
+package sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/erickmanovei/webhookinspector-cli/pkg/websocket"
+)
+
+// BusSink publishes each webhook, JSON-encoded, to a NATS subject.
+// The connection is established lazily on the first Send and reused
+// afterwards.
+type BusSink struct {
+	URL     string
+	Subject string
+
+	mu   sync.Mutex
+	conn *nats.Conn
+}
+
+// Send publishes payload to s.Subject, connecting to s.URL if not
+// already connected.
+func (s *BusSink) Send(payload websocket.WebhookPayload) (*Outcome, error) {
+	conn, err := s.connection()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("encoding payload: %w", err)
+	}
+	if err := conn.Publish(s.Subject, data); err != nil {
+		return nil, fmt.Errorf("publishing to %s: %w", s.Subject, err)
+	}
+	return nil, nil
+}
+
+func (s *BusSink) connection() (*nats.Conn, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn != nil && s.conn.IsConnected() {
+		return s.conn, nil
+	}
+
+	conn, err := nats.Connect(s.URL)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to %s: %w", s.URL, err)
+	}
+	s.conn = conn
+	return conn, nil
+}
@@ -0,0 +1,84 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/erickmanovei/webhookinspector-cli/pkg/websocket"
+)
+
+// DefaultExecTimeout bounds how long an ExecSink's command may run
+// before it is killed, when Timeout is unset. forwardingVisitor runs
+// sinks synchronously on the single websocket read goroutine, so an
+// unbounded exec sink would otherwise wedge the whole listen loop.
+const DefaultExecTimeout = 30 * time.Second
+
+// ExecSink pipes a webhook's body to a local command's stdin, passing
+// the method, path, id and headers as WEBHOOK_* environment
+// variables. Useful for recover-script-style automation.
+type ExecSink struct {
+	Command string
+	Args    []string
+	// Timeout bounds how long Command may run before it is killed.
+	// Defaults to DefaultExecTimeout.
+	Timeout time.Duration
+}
+
+// Send runs s.Command with the webhook's body on stdin, killing it if
+// it hasn't exited within s.Timeout (or DefaultExecTimeout).
+func (s *ExecSink) Send(payload websocket.WebhookPayload) (*Outcome, error) {
+	timeout := s.Timeout
+	if timeout == 0 {
+		timeout = DefaultExecTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, s.Command, s.Args...)
+	cmd.Stdin = bytes.NewReader(payload.Body)
+	cmd.Env = append(os.Environ(), envFor(payload)...)
+
+	output, err := cmd.CombinedOutput()
+	if ctx.Err() == context.DeadlineExceeded {
+		return nil, fmt.Errorf("running %s: timed out after %s", s.Command, timeout)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("running %s: %w (output: %s)", s.Command, err, output)
+	}
+	return nil, nil
+}
+
+func envFor(payload websocket.WebhookPayload) []string {
+	env := []string{
+		"WEBHOOK_ID=" + payload.ID,
+		"WEBHOOK_METHOD=" + payload.Method,
+		"WEBHOOK_PATH=" + payload.Path,
+	}
+	for name, value := range payload.Headers {
+		env = append(env, "WEBHOOK_HEADER_"+sanitizeEnvName(name)+"="+value)
+	}
+	return env
+}
+
+// sanitizeEnvName upper-cases name and replaces characters that are
+// not valid in a POSIX environment variable name with underscores.
+func sanitizeEnvName(name string) string {
+	out := make([]byte, len(name))
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c >= 'a' && c <= 'z':
+			out[i] = c - 'a' + 'A'
+		case c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+			out[i] = c
+		default:
+			out[i] = '_'
+		}
+	}
+	return string(out)
+}
@@ -0,0 +1,54 @@
+package sink
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/erickmanovei/webhookinspector-cli/pkg/config"
+	"github.com/erickmanovei/webhookinspector-cli/pkg/proxy"
+)
+
+// Build turns cfg.Sinks into a FanOut. If cfg.Sinks is empty, it falls
+// back to a single HTTP sink routed through p, preserving the tool's
+// pre-sinks behavior.
+func Build(cfg *config.Config, p *proxy.Proxy) (FanOut, error) {
+	if len(cfg.Sinks) == 0 {
+		return FanOut{Sinks: []Sink{&HTTPSink{Proxy: p}}}, nil
+	}
+
+	sinks := make([]Sink, 0, len(cfg.Sinks))
+	for _, sc := range cfg.Sinks {
+		s, err := build(sc, p)
+		if err != nil {
+			return FanOut{}, err
+		}
+		sinks = append(sinks, s)
+	}
+	return FanOut{Sinks: sinks}, nil
+}
+
+// build constructs the Sink for sc. An "http" sink reuses p's Rules,
+// VerifySignature and HeaderRewrites so that configuring any sink
+// doesn't silently drop routing, signature verification, or header
+// rewriting for HTTP delivery.
+func build(sc config.SinkConfig, p *proxy.Proxy) (Sink, error) {
+	switch sc.Type {
+	case "http":
+		httpProxy := proxy.NewWithRules(sc.Endpoint, p.Rules)
+		httpProxy.VerifySignature = p.VerifySignature
+		httpProxy.HeaderRewrites = p.HeaderRewrites
+		return &HTTPSink{Proxy: httpProxy}, nil
+	case "exec":
+		sk := &ExecSink{Command: sc.Command, Args: sc.Args}
+		if sc.TimeoutSeconds > 0 {
+			sk.Timeout = time.Duration(sc.TimeoutSeconds) * time.Second
+		}
+		return sk, nil
+	case "file":
+		return &FileSink{Path: sc.Path}, nil
+	case "nats":
+		return &BusSink{URL: sc.URL, Subject: sc.Subject}, nil
+	default:
+		return nil, fmt.Errorf("unknown sink type %q", sc.Type)
+	}
+}
@@ -0,0 +1,90 @@
+package sink
+
+import (
+	"testing"
+
+	"github.com/erickmanovei/webhookinspector-cli/pkg/config"
+	"github.com/erickmanovei/webhookinspector-cli/pkg/proxy"
+)
+
+func TestBuildFallsBackToHTTPSinkWhenNoneConfigured(t *testing.T) {
+	p := proxy.New("http://local.example")
+	fanOut, err := Build(&config.Config{}, p)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	if len(fanOut.Sinks) != 1 {
+		t.Fatalf("expected exactly one fallback sink, got %d", len(fanOut.Sinks))
+	}
+	httpSink, ok := fanOut.Sinks[0].(*HTTPSink)
+	if !ok || httpSink.Proxy != p {
+		t.Error("expected the fallback sink to wrap the given Proxy directly")
+	}
+}
+
+func TestBuildHTTPSinkReusesParentProxyConfig(t *testing.T) {
+	p := proxy.NewWithRules("http://local.example", []config.Rule{
+		{Match: config.Match{Path: "/stripe/*"}, Forward: "http://stripe.example"},
+	})
+	p.VerifySignature = true
+	p.HeaderRewrites = []config.HeaderRewrite{{Name: "X-Test", Value: "1"}}
+
+	fanOut, err := Build(&config.Config{
+		Sinks: []config.SinkConfig{{Type: "http", Endpoint: "http://sink.example"}},
+	}, p)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	httpSink, ok := fanOut.Sinks[0].(*HTTPSink)
+	if !ok {
+		t.Fatalf("expected an *HTTPSink, got %T", fanOut.Sinks[0])
+	}
+	if httpSink.Proxy.Endpoint != "http://sink.example" {
+		t.Errorf("got Endpoint %q, want the sink's own endpoint", httpSink.Proxy.Endpoint)
+	}
+	if len(httpSink.Proxy.Rules) != 1 || !httpSink.Proxy.VerifySignature || len(httpSink.Proxy.HeaderRewrites) != 1 {
+		t.Error("expected the sink's Proxy to reuse the parent's Rules/VerifySignature/HeaderRewrites")
+	}
+}
+
+func TestBuildExecSinkAppliesTimeout(t *testing.T) {
+	fanOut, err := Build(&config.Config{
+		Sinks: []config.SinkConfig{{Type: "exec", Command: "true", TimeoutSeconds: 5}},
+	}, proxy.New("http://local.example"))
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	execSink, ok := fanOut.Sinks[0].(*ExecSink)
+	if !ok {
+		t.Fatalf("expected an *ExecSink, got %T", fanOut.Sinks[0])
+	}
+	if execSink.Timeout.Seconds() != 5 {
+		t.Errorf("got Timeout %s, want 5s", execSink.Timeout)
+	}
+}
+
+func TestBuildExecSinkDefaultsTimeoutWhenUnset(t *testing.T) {
+	fanOut, err := Build(&config.Config{
+		Sinks: []config.SinkConfig{{Type: "exec", Command: "true"}},
+	}, proxy.New("http://local.example"))
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	execSink := fanOut.Sinks[0].(*ExecSink)
+	if execSink.Timeout != 0 {
+		t.Errorf("got Timeout %s, want zero so Send falls back to DefaultExecTimeout", execSink.Timeout)
+	}
+}
+
+func TestBuildRejectsUnknownSinkType(t *testing.T) {
+	_, err := Build(&config.Config{
+		Sinks: []config.SinkConfig{{Type: "carrier-pigeon"}},
+	}, proxy.New("http://local.example"))
+	if err == nil {
+		t.Fatal("expected an error for an unknown sink type")
+	}
+}
@@ -0,0 +1,34 @@
+package sink
+
+import (
+	"errors"
+
+	"github.com/erickmanovei/webhookinspector-cli/pkg/websocket"
+)
+
+// FanOut sends every webhook to each of its Sinks, continuing on
+// individual failures so one bad sink doesn't stop delivery to the
+// rest.
+type FanOut struct {
+	Sinks []Sink
+}
+
+// Send delivers payload to every sink, returning a joined error if any
+// sink failed. The returned Outcome is the first non-nil one among the
+// sinks that succeeded (in configuration order), for callers that want to surface a
+// response, such as the --tui inspector.
+func (f FanOut) Send(payload websocket.WebhookPayload) (*Outcome, error) {
+	var errs []error
+	var outcome *Outcome
+	for _, s := range f.Sinks {
+		result, err := s.Send(payload)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if outcome == nil {
+			outcome = result
+		}
+	}
+	return outcome, errors.Join(errs...)
+}
@@ -0,0 +1,92 @@
+package sink
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/erickmanovei/webhookinspector-cli/pkg/websocket"
+)
+
+// stubSink is a Sink whose Send result is fixed at construction,
+// recording whether it was called.
+type stubSink struct {
+	outcome *Outcome
+	err     error
+	called  bool
+}
+
+func (s *stubSink) Send(websocket.WebhookPayload) (*Outcome, error) {
+	s.called = true
+	return s.outcome, s.err
+}
+
+func TestFanOutSendDeliversToEverySink(t *testing.T) {
+	a := &stubSink{}
+	b := &stubSink{}
+	f := FanOut{Sinks: []Sink{a, b}}
+
+	if _, err := f.Send(websocket.WebhookPayload{}); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if !a.called || !b.called {
+		t.Error("expected Send to reach every sink")
+	}
+}
+
+func TestFanOutSendContinuesAfterAFailure(t *testing.T) {
+	failing := &stubSink{err: errors.New("boom")}
+	ok := &stubSink{}
+	f := FanOut{Sinks: []Sink{failing, ok}}
+
+	if _, err := f.Send(websocket.WebhookPayload{}); err == nil {
+		t.Fatal("expected Send to return the failing sink's error")
+	}
+	if !ok.called {
+		t.Error("expected a later sink to still be delivered to after an earlier one failed")
+	}
+}
+
+func TestFanOutSendJoinsAllErrors(t *testing.T) {
+	first := &stubSink{err: errors.New("first failed")}
+	second := &stubSink{err: errors.New("second failed")}
+	f := FanOut{Sinks: []Sink{first, second}}
+
+	_, err := f.Send(websocket.WebhookPayload{})
+	if err == nil {
+		t.Fatal("expected a joined error")
+	}
+	if !errors.Is(err, first.err) || !errors.Is(err, second.err) {
+		t.Errorf("expected the joined error to wrap both sink errors, got %v", err)
+	}
+}
+
+func TestFanOutSendReturnsFirstOutcome(t *testing.T) {
+	want := &Outcome{StatusCode: 204}
+	f := FanOut{Sinks: []Sink{
+		&stubSink{outcome: want},
+		&stubSink{outcome: &Outcome{StatusCode: 500}},
+	}}
+
+	got, err := f.Send(websocket.WebhookPayload{})
+	if err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if got != want {
+		t.Errorf("expected the first sink's Outcome to win, got %+v", got)
+	}
+}
+
+func TestFanOutSendSkipsOutcomeFromFailedSink(t *testing.T) {
+	f := FanOut{Sinks: []Sink{
+		&stubSink{err: errors.New("boom"), outcome: &Outcome{StatusCode: 500}},
+		&stubSink{outcome: &Outcome{StatusCode: 200}},
+	}}
+
+	got, err := f.Send(websocket.WebhookPayload{})
+	if err == nil {
+		t.Fatal("expected the failing sink's error to be reported")
+	}
+	if got == nil || got.StatusCode != 200 {
+		t.Errorf("expected the Outcome from the succeeding sink, got %+v", got)
+	}
+}
@@ -0,0 +1,32 @@
+package sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/erickmanovei/webhookinspector-cli/pkg/websocket"
+)
+
+// FileSink appends every webhook as one JSON line to a file.
+type FileSink struct {
+	Path string
+}
+
+// Send appends payload to s.Path as a single JSON line.
+func (s *FileSink) Send(payload websocket.WebhookPayload) (*Outcome, error) {
+	f, err := os.OpenFile(s.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("opening sink file: %w", err)
+	}
+	defer f.Close()
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("encoding payload: %w", err)
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return nil, fmt.Errorf("writing to sink file: %w", err)
+	}
+	return nil, nil
+}
@@ -0,0 +1,26 @@
+package sink
+
+import (
+	"github.com/erickmanovei/webhookinspector-cli/pkg/proxy"
+	"github.com/erickmanovei/webhookinspector-cli/pkg/websocket"
+)
+
+// HTTPSink forwards a webhook to a local endpoint via a Proxy.
+type HTTPSink struct {
+	Proxy *proxy.Proxy
+}
+
+// Send forwards payload through the underlying Proxy.
+func (s *HTTPSink) Send(payload websocket.WebhookPayload) (*Outcome, error) {
+	result, err := s.Proxy.Forward(payload)
+	if err != nil {
+		return nil, err
+	}
+	return &Outcome{
+		StatusCode:        result.StatusCode,
+		Body:              result.Body,
+		SignatureChecked:  result.SignatureChecked,
+		SignatureHeader:   result.SignatureHeader,
+		SignatureDetected: result.SignatureDetected,
+	}, nil
+}
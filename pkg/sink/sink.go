@@ -0,0 +1,29 @@
+// Package sink generalizes where a received webhook is delivered to:
+// a local HTTP endpoint, a local script, a JSONL file, or a NATS
+// subject. This lets the CLI run in environments with no HTTP server
+// to receive the callback, such as CI pipelines and headless test
+// rigs.
+package sink
+
+import "github.com/erickmanovei/webhookinspector-cli/pkg/websocket"
+
+// Outcome describes a sink's response to a delivered payload, for
+// sinks that have one (currently only HTTPSink). Sinks with no
+// meaningful response, such as ExecSink or FileSink, return a nil
+// Outcome.
+type Outcome struct {
+	StatusCode int
+	Body       []byte
+
+	// SignatureChecked is true when the underlying HTTPSink's Proxy had
+	// VerifySignature set for this delivery; SignatureHeader/
+	// SignatureDetected are only meaningful when this is true.
+	SignatureChecked  bool
+	SignatureHeader   string
+	SignatureDetected bool
+}
+
+// Sink delivers a webhook payload to one destination.
+type Sink interface {
+	Send(payload websocket.WebhookPayload) (*Outcome, error)
+}
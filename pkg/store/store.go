@@ -0,0 +1,171 @@
+// Package store persists received webhooks to disk as a rolling,
+// file-per-event log so they can be replayed later.
+package store
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/erickmanovei/webhookinspector-cli/pkg/websocket"
+)
+
+// Record is a single webhook persisted to the store. EventID uniquely
+// identifies this record for replay; Payload.ID is the inspector ID
+// shared by every received webhook and is not suitable for lookup.
+type Record struct {
+	Seq        int                      `json:"seq"`
+	EventID    string                   `json:"eventId"`
+	ReceivedAt time.Time                `json:"receivedAt"`
+	Payload    websocket.WebhookPayload `json:"payload"`
+}
+
+// Store persists the last Max webhooks received to Dir, one JSON file
+// per event. A Max of zero or less keeps every event.
+type Store struct {
+	Dir string
+	Max int
+}
+
+// New creates a Store rooted at dir, retaining at most max events
+// (every event if max <= 0).
+func New(dir string, max int) *Store {
+	return &Store{Dir: dir, Max: max}
+}
+
+// Save persists payload as the next record in the store and prunes the
+// oldest records beyond s.Max.
+func (s *Store) Save(payload websocket.WebhookPayload) (Record, error) {
+	if err := os.MkdirAll(s.Dir, 0755); err != nil {
+		return Record{}, fmt.Errorf("creating history dir: %w", err)
+	}
+
+	records, err := s.List()
+	if err != nil {
+		return Record{}, err
+	}
+
+	seq := 1
+	if len(records) > 0 {
+		seq = records[len(records)-1].Seq + 1
+	}
+
+	eventID, err := newEventID()
+	if err != nil {
+		return Record{}, fmt.Errorf("generating event id: %w", err)
+	}
+
+	record := Record{Seq: seq, EventID: eventID, ReceivedAt: time.Now().UTC(), Payload: payload}
+	data, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return Record{}, fmt.Errorf("encoding record: %w", err)
+	}
+	if err := os.WriteFile(s.path(record), data, 0644); err != nil {
+		return Record{}, fmt.Errorf("writing record: %w", err)
+	}
+
+	records = append(records, record)
+	if s.Max > 0 {
+		for len(records) > s.Max {
+			if err := os.Remove(s.path(records[0])); err != nil && !os.IsNotExist(err) {
+				return Record{}, fmt.Errorf("pruning oldest record: %w", err)
+			}
+			records = records[1:]
+		}
+	}
+
+	return record, nil
+}
+
+func (s *Store) path(r Record) string {
+	return filepath.Join(s.Dir, fmt.Sprintf("%08d-%s.json", r.Seq, r.EventID))
+}
+
+// newEventID returns a short random hex identifier unique enough to
+// use as a replay key within a single store.
+func newEventID() (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// List returns every record currently in the store, ordered oldest
+// first.
+func (s *Store) List() ([]Record, error) {
+	entries, err := os.ReadDir(s.Dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("reading history dir: %w", err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".json") {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	records := make([]Record, 0, len(names))
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(s.Dir, name))
+		if err != nil {
+			return nil, fmt.Errorf("reading record %s: %w", name, err)
+		}
+		var record Record
+		if err := json.Unmarshal(data, &record); err != nil {
+			return nil, fmt.Errorf("parsing record %s: %w", name, err)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// Find returns the record matching ref, which may be a record's EventID
+// or a 1-based index into the list returned by List (oldest first).
+func (s *Store) Find(ref string) (Record, error) {
+	records, err := s.List()
+	if err != nil {
+		return Record{}, err
+	}
+
+	if idx, err := strconv.Atoi(ref); err == nil {
+		if idx < 1 || idx > len(records) {
+			return Record{}, fmt.Errorf("no record at index %d", idx)
+		}
+		return records[idx-1], nil
+	}
+
+	for _, record := range records {
+		if record.EventID == ref {
+			return record, nil
+		}
+	}
+	return Record{}, fmt.Errorf("no record with id %q", ref)
+}
+
+// Since returns every record received at or after t, oldest first.
+func (s *Store) Since(t time.Time) ([]Record, error) {
+	records, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []Record
+	for _, record := range records {
+		if !record.ReceivedAt.Before(t) {
+			matched = append(matched, record)
+		}
+	}
+	return matched, nil
+}
@@ -0,0 +1,133 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/erickmanovei/webhookinspector-cli/pkg/websocket"
+)
+
+func payload(id string) websocket.WebhookPayload {
+	return websocket.WebhookPayload{ID: id, Method: "POST", Path: "/hooks"}
+}
+
+func TestSaveAndList(t *testing.T) {
+	s := New(t.TempDir(), 0)
+
+	first, err := s.Save(payload("tenant-1"))
+	if err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	second, err := s.Save(payload("tenant-1"))
+	if err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	if first.EventID == second.EventID {
+		t.Fatal("expected distinct records to get distinct event ids")
+	}
+	if first.Seq != 1 || second.Seq != 2 {
+		t.Errorf("expected sequential Seq 1, 2; got %d, %d", first.Seq, second.Seq)
+	}
+
+	records, err := s.List()
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(records))
+	}
+}
+
+func TestSavePrunesBeyondMax(t *testing.T) {
+	s := New(t.TempDir(), 2)
+
+	for i := 0; i < 3; i++ {
+		if _, err := s.Save(payload("tenant-1")); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+	}
+
+	records, err := s.List()
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected pruning to cap the store at 2 records, got %d", len(records))
+	}
+	if records[0].Seq != 2 || records[1].Seq != 3 {
+		t.Errorf("expected the oldest record to be pruned first, got seqs %d, %d", records[0].Seq, records[1].Seq)
+	}
+}
+
+func TestFindByEventID(t *testing.T) {
+	s := New(t.TempDir(), 0)
+
+	// Every received webhook shares the same inspector/tenant ID, so
+	// Find must key off the store-assigned EventID, not Payload.ID.
+	first, err := s.Save(payload("same-tenant-id"))
+	if err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	second, err := s.Save(payload("same-tenant-id"))
+	if err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	found, err := s.Find(second.EventID)
+	if err != nil {
+		t.Fatalf("Find: %v", err)
+	}
+	if found.EventID != second.EventID {
+		t.Errorf("expected to find the second record (%s), got %s", second.EventID, found.EventID)
+	}
+	if found.EventID == first.EventID {
+		t.Error("Find returned the first record instead of the requested one")
+	}
+}
+
+func TestFindByIndex(t *testing.T) {
+	s := New(t.TempDir(), 0)
+
+	if _, err := s.Save(payload("tenant-1")); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	second, err := s.Save(payload("tenant-1"))
+	if err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	found, err := s.Find("2")
+	if err != nil {
+		t.Fatalf("Find: %v", err)
+	}
+	if found.EventID != second.EventID {
+		t.Errorf("expected index 2 to resolve to the second record")
+	}
+
+	if _, err := s.Find("99"); err == nil {
+		t.Error("expected an out-of-range index to error")
+	}
+}
+
+func TestSince(t *testing.T) {
+	s := New(t.TempDir(), 0)
+
+	if _, err := s.Save(payload("tenant-1")); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	cutoff := time.Now().UTC()
+	time.Sleep(time.Millisecond)
+	second, err := s.Save(payload("tenant-1"))
+	if err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	matched, err := s.Since(cutoff)
+	if err != nil {
+		t.Fatalf("Since: %v", err)
+	}
+	if len(matched) != 1 || matched[0].EventID != second.EventID {
+		t.Errorf("expected Since to return only the record received after cutoff")
+	}
+}
@@ -0,0 +1,33 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/erickmanovei/webhookinspector-cli/pkg/websocket"
+)
+
+// BuildCurl renders payload as a curl command that sends its method,
+// headers and body to target, so a developer can replay it by hand
+// outside the tool. Unlike Proxy.Forward, it does not add payload.Query
+// to target, strip hop-by-hop headers, or apply header rewrites.
+func BuildCurl(payload websocket.WebhookPayload, target string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "curl -X %s %q", payload.Method, target)
+
+	keys := make([]string, 0, len(payload.Headers))
+	for key := range payload.Headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(&b, " \\\n  -H %q", key+": "+payload.Headers[key])
+	}
+
+	if len(payload.Body) > 0 {
+		fmt.Fprintf(&b, " \\\n  -d %q", string(payload.Body))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,37 @@
+// Package tui implements the optional --tui inspector view: a live log
+// of incoming webhooks, what they were forwarded to, and the local
+// endpoint's response.
+package tui
+
+import (
+	"time"
+
+	"github.com/erickmanovei/webhookinspector-cli/pkg/websocket"
+)
+
+// EventKind identifies what an Event represents.
+type EventKind string
+
+const (
+	EventWebhook   EventKind = "webhook"
+	EventForwarded EventKind = "forwarded"
+	EventError     EventKind = "error"
+	EventWarning   EventKind = "warning"
+	EventNotice    EventKind = "notice"
+)
+
+// Event is a single entry in the inspector's live log.
+type Event struct {
+	Kind    EventKind
+	At      time.Time
+	Webhook websocket.WebhookPayload
+	Target  string
+	Status  string
+	Text    string
+
+	// ResponseStatusCode and ResponseBody are the local endpoint's
+	// response, set on an EventForwarded built from a successful
+	// sink.Outcome.
+	ResponseStatusCode int
+	ResponseBody       []byte
+}
@@ -0,0 +1,211 @@
+package tui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/erickmanovei/webhookinspector-cli/pkg/clipboard"
+	"github.com/erickmanovei/webhookinspector-cli/pkg/proxy"
+	"github.com/erickmanovei/webhookinspector-cli/pkg/sink"
+)
+
+// maxEvents bounds how many log entries the TUI keeps in memory.
+const maxEvents = 200
+
+// Model is the bubbletea model backing --tui. It renders events
+// published on a channel and lets the user re-forward, copy as curl,
+// or drop the selected entry.
+type Model struct {
+	proxy *proxy.Proxy
+	sinks sink.FanOut
+
+	events <-chan Event
+
+	log    []Event
+	cursor int
+	status string
+}
+
+// New creates a Model that reads events from ch and uses p to resolve
+// forward targets and sinks to re-forward webhooks on demand, so a
+// re-forward honors the same routing, signature verification, and
+// configured sinks as the live listen loop.
+func New(p *proxy.Proxy, sinks sink.FanOut, ch <-chan Event) Model {
+	return Model{proxy: p, sinks: sinks, events: ch}
+}
+
+func (m Model) Init() tea.Cmd {
+	return waitForEvent(m.events)
+}
+
+func waitForEvent(ch <-chan Event) tea.Cmd {
+	return func() tea.Msg {
+		event, ok := <-ch
+		if !ok {
+			return nil
+		}
+		return event
+	}
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case Event:
+		m.log = append(m.log, msg)
+		if len(m.log) > maxEvents {
+			m.log = m.log[len(m.log)-maxEvents:]
+		}
+		m.cursor = len(m.log) - 1
+		return m, waitForEvent(m.events)
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.log)-1 {
+				m.cursor++
+			}
+		case "r":
+			m.reforward()
+		case "c":
+			m.copyAsCurl()
+		case "d":
+			m.drop()
+		}
+	}
+	return m, nil
+}
+
+func (m *Model) selected() *Event {
+	if m.cursor < 0 || m.cursor >= len(m.log) {
+		return nil
+	}
+	return &m.log[m.cursor]
+}
+
+func (m *Model) reforward() {
+	event := m.selected()
+	if event == nil || event.Kind != EventWebhook {
+		m.status = "nothing to re-forward"
+		return
+	}
+	if _, err := m.sinks.Send(event.Webhook); err != nil {
+		m.status = fmt.Sprintf("re-forward failed: %s", err)
+		return
+	}
+	m.status = fmt.Sprintf("re-forwarded %s", event.Webhook.ID)
+}
+
+func (m *Model) copyAsCurl() {
+	event := m.selected()
+	if event == nil || event.Kind != EventWebhook {
+		m.status = "nothing to copy"
+		return
+	}
+	target := event.Target
+	if target == "" {
+		target = m.proxy.Resolve(event.Webhook)
+	}
+	if err := clipboard.Copy(BuildCurl(event.Webhook, target)); err != nil {
+		m.status = fmt.Sprintf("copy failed: %s", err)
+		return
+	}
+	m.status = "copied as curl"
+}
+
+func (m *Model) drop() {
+	if m.cursor < 0 || m.cursor >= len(m.log) {
+		return
+	}
+	m.log = append(m.log[:m.cursor], m.log[m.cursor+1:]...)
+	if m.cursor >= len(m.log) {
+		m.cursor = len(m.log) - 1
+	}
+	m.status = "dropped"
+}
+
+func (m Model) View() string {
+	var b strings.Builder
+	b.WriteString("Webhook Inspector — r: re-forward  c: copy as curl  d: drop  q: quit\n\n")
+
+	for i, event := range m.log {
+		cursor := "  "
+		if i == m.cursor {
+			cursor = "> "
+		}
+		fmt.Fprintf(&b, "%s%s\n", cursor, describe(event))
+	}
+
+	if detail := m.detail(); detail != "" {
+		fmt.Fprintf(&b, "\n%s\n", detail)
+	}
+
+	if m.status != "" {
+		fmt.Fprintf(&b, "\n%s\n", m.status)
+	}
+	return b.String()
+}
+
+func describe(event Event) string {
+	ts := event.At.Format("15:04:05")
+	switch event.Kind {
+	case EventWebhook:
+		return fmt.Sprintf("[%s] %s %s %s", ts, event.Webhook.Method, event.Webhook.Path, event.Webhook.ID)
+	case EventForwarded:
+		return fmt.Sprintf("[%s] -> %s (%s)", ts, event.Target, event.Status)
+	case EventError:
+		return fmt.Sprintf("[%s] error: %s", ts, event.Text)
+	case EventWarning:
+		return fmt.Sprintf("[%s] warning: %s", ts, event.Text)
+	case EventNotice:
+		return fmt.Sprintf("[%s] notice: %s", ts, event.Text)
+	default:
+		return fmt.Sprintf("[%s] %s", ts, event.Text)
+	}
+}
+
+// detail renders the selected log entry's headers, pretty-printed
+// body, and (for a forwarded webhook) the local endpoint's response.
+func (m Model) detail() string {
+	event := m.selected()
+	if event == nil || event.Kind != EventWebhook {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("--- headers ---\n")
+	for name, value := range event.Webhook.Headers {
+		fmt.Fprintf(&b, "%s: %s\n", name, value)
+	}
+	fmt.Fprintf(&b, "\n--- body ---\n%s\n", prettyBody(event.Webhook.Body))
+
+	if i := m.cursor + 1; i < len(m.log) && m.log[i].Kind == EventForwarded {
+		forwarded := m.log[i]
+		fmt.Fprintf(&b, "\n--- response (%d) ---\n%s\n", forwarded.ResponseStatusCode, prettyBody(forwarded.ResponseBody))
+	}
+
+	return b.String()
+}
+
+// prettyBody indents body as JSON when it parses as such, and falls
+// back to the raw bytes otherwise (e.g. form-encoded or binary
+// payloads).
+func prettyBody(body []byte) string {
+	if len(body) == 0 {
+		return "(empty)"
+	}
+	var pretty bytes.Buffer
+	if err := json.Indent(&pretty, body, "", "  "); err == nil {
+		return pretty.String()
+	}
+	return string(body)
+}
@@ -0,0 +1,170 @@
+// Package websocket owns the connection to the Webhook Inspector service:
+// dialing, reconnecting and the ping/pong keepalive loop. Incoming
+// messages are dispatched to a caller-supplied Visitor so consumers can
+// handle each message type without depending on the wire format.
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	gorilla "github.com/gorilla/websocket"
+)
+
+// ReconnectDelay is how long Run waits before redialing after the
+// connection drops.
+const ReconnectDelay = 5 * time.Second
+
+const pingInterval = 30 * time.Second
+const pongWait = 10 * time.Second
+
+// WebhookPayload represents a single webhook request forwarded by the
+// Webhook Inspector service. Body carries the exact bytes the sender
+// posted, transported as base64 over the WebSocket frame, so non-JSON
+// payloads (form-encoded, XML, protobuf, binary) and HMAC signatures
+// computed over the raw body survive the round trip unchanged.
+type WebhookPayload struct {
+	ID      string            `json:"id"`
+	Path    string            `json:"path"`
+	Headers map[string]string `json:"headers"`
+	Body    []byte            `json:"body"`
+	Method  string            `json:"method"`
+	Query   map[string]string `json:"query"`
+}
+
+// message is the envelope every frame is decoded into before it is
+// routed to the Visitor.
+type message struct {
+	Type    string         `json:"type"`
+	Payload WebhookPayload `json:"payload"`
+	Text    string         `json:"message"`
+}
+
+// Message types understood on the wire. Frames with no "type", or a
+// type other than error, warning or notice, are dispatched as
+// MessageTypeWebhook; their payload is always read from the "payload"
+// field, so a bare top-level payload is not decoded as a webhook.
+const (
+	MessageTypeWebhook = "webhook"
+	MessageTypeError   = "error"
+	MessageTypeWarning = "warning"
+	MessageTypeNotice  = "notice"
+)
+
+// Visitor receives decoded messages from a Client. Implementations can
+// plug in whatever handling they need for each kind without the Client
+// needing to know about it.
+type Visitor interface {
+	VisitWebhook(WebhookPayload)
+	VisitError(text string)
+	VisitWarning(text string)
+	VisitNotice(text string)
+}
+
+// Client manages a single logical connection to the Webhook Inspector
+// WebSocket endpoint, including automatic reconnection.
+type Client struct {
+	URL         string
+	InspectorID string
+}
+
+// NewClient creates a Client targeting url, filtering webhook messages
+// to those whose payload ID matches inspectorID. Error, warning and
+// notice messages are not filtered.
+func NewClient(url, inspectorID string) *Client {
+	return &Client{URL: url, InspectorID: inspectorID}
+}
+
+// Run connects to the service and dispatches messages to visitor until
+// ctx is cancelled, reconnecting with ReconnectDelay between attempts.
+func (c *Client) Run(ctx context.Context, visitor Visitor) {
+	for {
+		if err := c.connectAndListen(ctx, visitor); err != nil {
+			fmt.Println("Error connecting to WebSocket:", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		default:
+			fmt.Println("Connection lost. Reconnecting in", ReconnectDelay)
+			select {
+			case <-time.After(ReconnectDelay):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+func (c *Client) connectAndListen(ctx context.Context, visitor Visitor) error {
+	conn, _, err := gorilla.DefaultDialer.Dial(c.URL, nil)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	fmt.Println("Connected! Listening for events...")
+
+	conn.SetPingHandler(func(string) error {
+		return conn.WriteControl(gorilla.PongMessage, []byte{}, time.Now().Add(pongWait))
+	})
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		ticker := time.NewTicker(pingInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := conn.WriteControl(gorilla.PingMessage, []byte{}, time.Now().Add(pongWait)); err != nil {
+					return
+				}
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	for {
+		_, raw, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+
+		var msg message
+		if err := json.Unmarshal(raw, &msg); err != nil {
+			fmt.Println("Error decoding JSON:", err)
+			continue
+		}
+
+		switch msg.Type {
+		case MessageTypeError:
+			visitor.VisitError(msg.Text)
+		case MessageTypeWarning:
+			visitor.VisitWarning(msg.Text)
+		case MessageTypeNotice:
+			visitor.VisitNotice(msg.Text)
+		default:
+			if msg.Payload.ID != c.InspectorID {
+				fmt.Println("Webhook received with different id. Ignoring.")
+				continue
+			}
+			visitor.VisitWebhook(msg.Payload)
+		}
+	}
+}